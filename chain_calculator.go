@@ -0,0 +1,123 @@
+package collatz
+
+import (
+	"math"
+	"math/big"
+	"sync/atomic"
+)
+
+// ChainCalculator memoizes Collatz chain lengths for integers below cacheSize,
+// so scanning a whole range only ever walks each number's tail once. Results
+// for n >= cacheSize are still computed correctly, just not cached, which
+// bounds memory use independently of the range being searched.
+//
+// The memo is safe for concurrent use without a lock: each slot is read and
+// written with a single atomic access, and a race between two goroutines
+// computing the same n is benign (Length is pure, so both would write the
+// same value). A global mutex would instead serialize every worker on every
+// lookup, which defeats the point of splitting work across goroutines.
+type ChainCalculator struct {
+	cache     []int32
+	cacheSize int
+}
+
+// NewChainCalculator returns a ChainCalculator that memoizes chain lengths for
+// every n in [1, cacheSize).
+func NewChainCalculator(cacheSize int) *ChainCalculator {
+	if cacheSize < 0 {
+		cacheSize = 0
+	}
+	return &ChainCalculator{
+		cache:     make([]int32, cacheSize),
+		cacheSize: cacheSize,
+	}
+}
+
+// defaultShardCacheSize bounds the memo defaultCalculator builds,
+// independent of how large a range it's asked to scan.
+const defaultShardCacheSize = 1 << 20
+
+// defaultCalculator backs the package-level CollatzChainLength so existing
+// single-threaded callers get memoization for free.
+var defaultCalculator = NewChainCalculator(defaultShardCacheSize)
+
+// Length returns the number of terms in the Collatz chain starting at n. The
+// walk stops as soon as it reaches a term already present in the memo, adding
+// the cached remainder instead of recomputing it.
+func (c *ChainCalculator) Length(n int) int {
+	if n < 1 {
+		return 0
+	}
+	if cached := c.peek(n); cached > 0 {
+		return cached
+	}
+
+	term := n
+	steps := 0
+	for term != 1 {
+		if term != n {
+			if cached := c.peek(term); cached > 0 {
+				length := steps + cached
+				c.memoize(n, length)
+				return length
+			}
+		}
+		if term%2 != 0 && term > math.MaxInt64/3 {
+			length := steps + c.lengthBig(big.NewInt(int64(term)))
+			c.memoize(n, length)
+			return length
+		}
+		term = nextTerm(term)
+		steps++
+	}
+	length := steps + 1
+	c.memoize(n, length)
+	return length
+}
+
+// LongestUpTo returns the starting number with the longest chain in [1, n]
+// and that chain's length.
+func (c *ChainCalculator) LongestUpTo(n int) (start, length int) {
+	start, length = 1, 1
+	for i := 1; i <= n; i++ {
+		if l := c.Length(i); l > length {
+			start, length = i, l
+		}
+	}
+	return start, length
+}
+
+func (c *ChainCalculator) peek(n int) int {
+	if n <= 0 || n >= c.cacheSize {
+		return 0
+	}
+	return int(atomic.LoadInt32(&c.cache[n]))
+}
+
+func (c *ChainCalculator) memoize(n, length int) {
+	if n <= 0 || n >= c.cacheSize || length > math.MaxInt32 {
+		return
+	}
+	atomic.StoreInt32(&c.cache[n], int32(length))
+}
+
+// lengthBig continues the walk using arbitrary precision once 3*term+1 would
+// overflow a machine int, which only happens for seeds deliberately chosen
+// close to math.MaxInt64.
+func (c *ChainCalculator) lengthBig(term *big.Int) int {
+	one := big.NewInt(1)
+	two := big.NewInt(2)
+	three := big.NewInt(3)
+
+	steps := 0
+	for term.Cmp(one) != 0 {
+		if term.Bit(0) == 0 {
+			term.Div(term, two)
+		} else {
+			term.Mul(term, three)
+			term.Add(term, one)
+		}
+		steps++
+	}
+	return steps + 1
+}
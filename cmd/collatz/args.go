@@ -0,0 +1,134 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ArgParser turns raw command-line input into validated Options. It's kept
+// as an interface so the same core (cmd/collatz, WASM exports, tests) can be
+// driven without duplicating parsing logic.
+type ArgParser interface {
+	Parse() (Options, error)
+}
+
+// Options describes what the CLI should run.
+type Options struct {
+	Command string // "longest", "chain", or "steps"
+	UpTo    int
+	Start   *big.Int
+	Output  string // "text" or "json"
+	Workers int
+}
+
+// Cliargs parses Options from a raw argument slice shaped like os.Args[1:].
+type Cliargs struct {
+	Args []string
+}
+
+// Parse implements ArgParser.
+func (c Cliargs) Parse() (Options, error) {
+	if len(c.Args) == 0 {
+		return Options{}, fmt.Errorf("usage: collatz <longest|chain|steps> [flags]")
+	}
+
+	opts := Options{Command: c.Args[0]}
+	fs := flag.NewFlagSet(opts.Command, flag.ContinueOnError)
+	fs.StringVar(&opts.Output, "output", "text", "output format: text or json")
+	fs.IntVar(&opts.Workers, "workers", 0, "number of worker goroutines (0 = GOMAXPROCS)")
+
+	switch opts.Command {
+	case "longest":
+		fs.IntVar(&opts.UpTo, "upto", 0, "search the range [1, upto]")
+		if err := fs.Parse(c.Args[1:]); err != nil {
+			return Options{}, err
+		}
+		if opts.UpTo < 1 {
+			return Options{}, fmt.Errorf("longest: --upto must be >= 1")
+		}
+	case "chain", "steps":
+		// chain/steps take exactly one positional operand (the start value),
+		// which flag.FlagSet won't let us place before a flag (it stops
+		// parsing at the first non-flag token). Pull the operand out first
+		// so "chain <start> --output json" works the same as
+		// "chain --output json <start>".
+		flagArgs, positional := splitPositionalArgs(c.Args[1:], chainFlagsWithValue)
+		if err := fs.Parse(flagArgs); err != nil {
+			if looksLikeNegativeStart(c.Args[1:]) {
+				return Options{}, fmt.Errorf("%s: negative start values are not supported; Collatz is only defined for positive integers", opts.Command)
+			}
+			return Options{}, err
+		}
+		if len(positional) != 1 {
+			return Options{}, fmt.Errorf("%s: expected a single start value", opts.Command)
+		}
+		start, ok := new(big.Int).SetString(positional[0], 10)
+		if !ok {
+			return Options{}, fmt.Errorf("%s: %q is not a valid integer", opts.Command, positional[0])
+		}
+		if start.Sign() <= 0 {
+			return Options{}, fmt.Errorf("%s: start must be a positive integer", opts.Command)
+		}
+		opts.Start = start
+	default:
+		return Options{}, fmt.Errorf("unknown command %q", opts.Command)
+	}
+
+	if opts.Output != "text" && opts.Output != "json" {
+		return Options{}, fmt.Errorf("--output must be text or json")
+	}
+	return opts, nil
+}
+
+// looksLikeNegativeStart reports whether args[0] is a negative number, which
+// the flag package otherwise rejects as an unrecognized flag (e.g. "-5").
+// Only the first argument is checked.
+func looksLikeNegativeStart(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	arg := args[0]
+	if !strings.HasPrefix(arg, "-") || strings.HasPrefix(arg, "--") {
+		return false
+	}
+	_, ok := new(big.Int).SetString(arg, 10)
+	return ok
+}
+
+// chainFlagsWithValue lists the chain/steps flags that consume a following
+// argument, so splitPositionalArgs knows not to mistake a flag's value for
+// the positional start operand.
+var chainFlagsWithValue = map[string]bool{"output": true, "workers": true}
+
+// splitPositionalArgs separates args into the tokens flag.FlagSet should
+// parse and the plain positional operands, so a positional operand can
+// appear anywhere relative to flags instead of only after the last one.
+// valueFlags names the recognized flags (without dashes) that take a
+// following value; a "--" token ends flag parsing and everything after it
+// is treated as positional.
+func splitPositionalArgs(args []string, valueFlags map[string]bool) (flagArgs, positional []string) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flagArgs = append(flagArgs, arg)
+		name := strings.TrimLeft(arg, "-")
+		if strings.ContainsRune(name, '=') {
+			continue // value is embedded in this token (--flag=value)
+		}
+		if valueFlags[name] && i+1 < len(args) {
+			i++
+			flagArgs = append(flagArgs, args[i])
+		}
+	}
+	return flagArgs, positional
+}
@@ -0,0 +1,66 @@
+package main
+
+import "testing"
+
+func TestCliargsParseLongest(t *testing.T) {
+	opts, err := (Cliargs{Args: []string{"longest", "--upto", "100"}}).Parse()
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Command != "longest" || opts.UpTo != 100 || opts.Output != "text" {
+		t.Errorf("unexpected opts: %+v", opts)
+	}
+}
+
+func TestCliargsParseChain(t *testing.T) {
+	opts, err := (Cliargs{Args: []string{"chain", "27"}}).Parse()
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Start == nil || opts.Start.Int64() != 27 {
+		t.Errorf("unexpected start: %v", opts.Start)
+	}
+}
+
+func TestCliargsParseChainFlagsAfterOperand(t *testing.T) {
+	// The natural command order puts flags after the positional start, but
+	// flag.FlagSet stops parsing at the first non-flag token, so this must
+	// be handled explicitly rather than left to fs.Parse.
+	opts, err := (Cliargs{Args: []string{"chain", "6", "--output", "json", "--workers", "2"}}).Parse()
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Start == nil || opts.Start.Int64() != 6 || opts.Output != "json" || opts.Workers != 2 {
+		t.Errorf("unexpected opts: %+v", opts)
+	}
+}
+
+func TestCliargsParseRejectsNonPositiveStart(t *testing.T) {
+	for _, args := range [][]string{
+		{"steps", "0"},
+		{"chain", "0"},
+	} {
+		if _, err := (Cliargs{Args: args}).Parse(); err == nil {
+			t.Errorf("Parse(%v): expected error for non-positive start", args)
+		}
+	}
+}
+
+func TestCliargsParseRejectsNegativeStart(t *testing.T) {
+	if _, err := (Cliargs{Args: []string{"chain", "-5"}}).Parse(); err == nil {
+		t.Error("Parse: expected error for negative start")
+	}
+}
+
+func TestCliargsParseRejectsBadOutput(t *testing.T) {
+	args := []string{"longest", "--upto", "10", "--output", "xml"}
+	if _, err := (Cliargs{Args: args}).Parse(); err == nil {
+		t.Error("Parse: expected error for invalid --output")
+	}
+}
+
+func TestCliargsParseRejectsUnknownCommand(t *testing.T) {
+	if _, err := (Cliargs{Args: []string{"bogus"}}).Parse(); err == nil {
+		t.Error("Parse: expected error for unknown command")
+	}
+}
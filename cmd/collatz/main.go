@@ -0,0 +1,97 @@
+// Command collatz is the CLI front-end for the core collatz package. It
+// supports three subcommands: "longest --upto N" (the original behavior),
+// "chain <start>" (print the full trajectory), and "steps <start>" (print
+// just the chain length).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"runtime"
+
+	collatz "github.com/thalesvb/sccc2-collatz"
+)
+
+func main() {
+	opts, err := (Cliargs{Args: os.Args[1:]}).Parse()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err := run(opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(opts Options) error {
+	switch opts.Command {
+	case "longest":
+		return runLongest(opts)
+	case "chain":
+		return runChain(opts)
+	case "steps":
+		return runSteps(opts)
+	default:
+		return fmt.Errorf("unknown command %q", opts.Command)
+	}
+}
+
+func runLongest(opts Options) error {
+	workers := int64(opts.Workers)
+	if workers <= 0 {
+		workers = int64(runtime.GOMAXPROCS(0))
+	}
+	result, err := collatz.DetermineLongestChainCtx(context.Background(), opts.UpTo, workers)
+	if err != nil {
+		return err
+	}
+	return printResult(opts.Output, map[string]any{"start": result[0], "length": result[1]})
+}
+
+func runChain(opts Options) error {
+	seq := collatz.CollatzSequence(opts.Start)
+	if opts.Output == "json" {
+		terms := make([]string, len(seq.Terms))
+		for i, t := range seq.Terms {
+			terms[i] = t.String()
+		}
+		return printResult(opts.Output, map[string]any{
+			"start":      seq.Start.String(),
+			"iterations": seq.Iterations,
+			"terms":      terms,
+		})
+	}
+	for i, t := range seq.Terms {
+		if i > 0 {
+			fmt.Print(" -> ")
+		}
+		fmt.Print(t.String())
+	}
+	fmt.Println()
+	return nil
+}
+
+func runSteps(opts Options) error {
+	length := 0
+	collatz.Iterate(opts.Start, func(term *big.Int) bool {
+		length++
+		return true
+	})
+	return printResult(opts.Output, map[string]any{"start": opts.Start.String(), "length": length})
+}
+
+func printResult(output string, data map[string]any) error {
+	if output == "json" {
+		return json.NewEncoder(os.Stdout).Encode(data)
+	}
+	for _, key := range []string{"start", "length", "iterations", "terms"} {
+		if v, ok := data[key]; ok {
+			fmt.Printf("%s: %v\n", key, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package collatz computes Collatz (3n+1) chain lengths and trajectories.
+// It is the shared core consumed by the CLI (cmd/collatz) and the WASM
+// bridge (modules/wasm/go) so neither has to duplicate the search logic.
+package collatz
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// DetermineLongestChain returns the {start, length} pair for the longest
+// Collatz chain in [1, upperBound], parallelized across GOMAXPROCS workers.
+func DetermineLongestChain(upperBound int) [2]int {
+	result, err := DetermineLongestChainCtx(context.Background(), upperBound, int64(runtime.GOMAXPROCS(0)))
+	if err != nil {
+		// context.Background() never cancels, so this is unreachable in practice.
+		return [2]int{1, 1}
+	}
+	return result
+}
+
+// DetermineLongestChainCtx computes the longest Collatz chain in
+// [1, upperBound], sharding the range across up to maxWorkers goroutines
+// bounded by a weighted semaphore and reducing each shard's winner under a
+// mutex. All shards share defaultCalculator: its memo is lock-free (see
+// ChainCalculator), and sharing it lets a shard scanning high numbers still
+// benefit from the lengths lower shards have already cached, which a
+// calculator private to each shard would not. maxWorkers <= 1 runs the
+// search on the calling goroutine, which keeps single-threaded callers
+// (e.g. the WASM build) working unchanged.
+func DetermineLongestChainCtx(ctx context.Context, upperBound int, maxWorkers int64) ([2]int, error) {
+	if upperBound < 1 {
+		return [2]int{1, 1}, nil
+	}
+	if maxWorkers <= 1 {
+		return determineLongestChainShard(ctx, 1, upperBound, defaultCalculator)
+	}
+	if maxWorkers > int64(upperBound) {
+		maxWorkers = int64(upperBound)
+	}
+
+	sem := semaphore.NewWeighted(maxWorkers)
+	shardSize := (upperBound + int(maxWorkers) - 1) / int(maxWorkers)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	lgChain := [2]int{1, 1}
+	var firstErr error
+
+	for lo := 1; lo <= upperBound; lo += shardSize {
+		hi := lo + shardSize - 1
+		if hi > upperBound {
+			hi = upperBound
+		}
+		if err := sem.Acquire(ctx, 1); err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+			break
+		}
+
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			best, err := determineLongestChainShard(ctx, lo, hi, defaultCalculator)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			// Shards finish in goroutine-completion order, not start order, so
+			// break length ties on the lowest start to keep the result stable
+			// across runs (matching the serial sweep's behavior).
+			if best[1] > lgChain[1] || (best[1] == lgChain[1] && best[0] < lgChain[0]) {
+				lgChain = best
+			}
+		}(lo, hi)
+	}
+
+	wg.Wait()
+	if firstErr != nil {
+		return lgChain, firstErr
+	}
+	return lgChain, nil
+}
+
+// determineLongestChainShard scans [lo, hi] using calc, checking ctx
+// periodically so a long-running shard can still be cancelled promptly.
+// calc may be shared with other concurrently running shards; see
+// ChainCalculator for why that's safe without a lock.
+func determineLongestChainShard(ctx context.Context, lo, hi int, calc *ChainCalculator) ([2]int, error) {
+	lgChain := [2]int{1, 1}
+	for i := lo; i <= hi; i++ {
+		if i%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return lgChain, err
+			}
+		}
+		if length := calc.Length(i); length > lgChain[1] {
+			lgChain[0] = i
+			lgChain[1] = length
+		}
+	}
+	return lgChain, nil
+}
+
+// CollatzChainLength returns the number of terms in the Collatz chain
+// starting at number, using the package's default memoized calculator.
+func CollatzChainLength(number int) int {
+	return defaultCalculator.Length(number)
+}
+
+func nextTerm(term int) int {
+	if term%2 != 0 {
+		return 3*term + 1
+	}
+	return term / 2
+}
@@ -0,0 +1,37 @@
+package collatz
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDetermineLongestChainCtxMatchesSerial(t *testing.T) {
+	const upperBound = 20000
+
+	serial, err := DetermineLongestChainCtx(context.Background(), upperBound, 1)
+	if err != nil {
+		t.Fatalf("serial search: %v", err)
+	}
+
+	// Run the parallel search several times: shards complete in
+	// goroutine-completion order, so a flaky tie-break would show up as a
+	// mismatch on some iteration even if it passed on others.
+	for i := 0; i < 20; i++ {
+		parallel, err := DetermineLongestChainCtx(context.Background(), upperBound, 8)
+		if err != nil {
+			t.Fatalf("parallel search (run %d): %v", i, err)
+		}
+		if parallel != serial {
+			t.Fatalf("parallel search (run %d) = %v, want %v (serial)", i, parallel, serial)
+		}
+	}
+}
+
+func TestDetermineLongestChainCtxRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := DetermineLongestChainCtx(ctx, 10_000_000, 4); err == nil {
+		t.Fatal("expected an error from an already-cancelled context")
+	}
+}
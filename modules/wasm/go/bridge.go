@@ -0,0 +1,81 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"sync/atomic"
+	"syscall/js"
+
+	collatz "github.com/thalesvb/sccc2-collatz"
+)
+
+// init registers JS-friendly entry points for builds compiled with the
+// standard `GOOS=js GOARCH=wasm go build` toolchain, as an alternative to the
+// TinyGo //export functions in collatz.go.
+func init() {
+	js.Global().Set("determineLongestChain", js.FuncOf(jsDetermineLongestChain))
+	js.Global().Set("collatzChainLength", js.FuncOf(jsCollatzChainLength))
+	js.Global().Set("determineLongestChainAsync", js.FuncOf(jsDetermineLongestChainAsync))
+}
+
+func jsDetermineLongestChain(this js.Value, args []js.Value) any {
+	if len(args) < 1 {
+		return jsError("determineLongestChain: expected 1 argument (upperBound)")
+	}
+	upperBound := args[0].Int()
+	result := collatz.DetermineLongestChain(upperBound)
+	return map[string]any{"start": result[0], "length": result[1]}
+}
+
+func jsCollatzChainLength(this js.Value, args []js.Value) any {
+	if len(args) < 1 {
+		return jsError("collatzChainLength: expected 1 argument (number)")
+	}
+	return collatz.CollatzChainLength(args[0].Int())
+}
+
+// jsDetermineLongestChainAsync searches [1, upperBound] for the longest
+// chain on a background goroutine, invoking the JS progressFn(processed,
+// currentBest) callback every chunkSize numbers so a browser UI can render a
+// progress bar without blocking. It returns a cancel() closure that trips an
+// atomic flag checked in the search loop, stopping the search early.
+func jsDetermineLongestChainAsync(this js.Value, args []js.Value) any {
+	if len(args) < 3 {
+		return jsError("determineLongestChainAsync: expected 3 arguments (upperBound, chunkSize, progressFn)")
+	}
+	upperBound := args[0].Int()
+	chunkSize := args[1].Int()
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+	progressFn := args[2]
+
+	var cancelled atomic.Bool
+	go func() {
+		bestStart, bestLength := 1, 1
+		for i := 1; i <= upperBound; i++ {
+			if cancelled.Load() {
+				return
+			}
+			if length := collatz.CollatzChainLength(i); length > bestLength {
+				bestStart, bestLength = i, length
+			}
+			if i%chunkSize == 0 {
+				progressFn.Invoke(i, map[string]any{"start": bestStart, "length": bestLength})
+			}
+		}
+		progressFn.Invoke(upperBound, map[string]any{"start": bestStart, "length": bestLength})
+	}()
+
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		cancelled.Store(true)
+		return nil
+	})
+}
+
+// jsError builds a JS Error value so callers that index js.Value results
+// consistently get an error object back instead of the runtime panicking on
+// missing arguments.
+func jsError(msg string) js.Value {
+	return js.Global().Get("Error").New(msg)
+}
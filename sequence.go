@@ -0,0 +1,63 @@
+package collatz
+
+import "math/big"
+
+// Sequence captures a full Collatz trajectory starting from Start, mirroring
+// the shape used by the gocollatz project so callers that need the
+// trajectory itself (not just its length) have somewhere to get it from.
+type Sequence struct {
+	Start      *big.Int
+	Iterations int
+	Terms      []*big.Int
+}
+
+// CollatzSequence walks the Collatz trajectory from start until it reaches 1,
+// buffering every term. It uses arbitrary precision throughout because 3n+1
+// steps grow unboundedly during the walk. Collatz is only defined for
+// positive integers, so start <= 0 yields an empty Sequence.
+func CollatzSequence(start *big.Int) Sequence {
+	seq := Sequence{Start: new(big.Int).Set(start)}
+	Iterate(start, func(term *big.Int) bool {
+		seq.Terms = append(seq.Terms, new(big.Int).Set(term))
+		return true
+	})
+	if len(seq.Terms) > 0 {
+		seq.Iterations = len(seq.Terms) - 1
+	}
+	return seq
+}
+
+// Iterate streams the Collatz trajectory from start, calling yield with each
+// term (including start itself) until the chain reaches 1 or yield returns
+// false. Unlike CollatzSequence, it never buffers the whole chain, so callers
+// can print terms as they're produced. Collatz is only defined for positive
+// integers: start <= 0 would either loop at 0 forever or enter a negative
+// cycle that never reaches 1, so Iterate returns immediately without
+// yielding anything in that case.
+func Iterate(start *big.Int, yield func(term *big.Int) bool) {
+	if start.Sign() <= 0 {
+		return
+	}
+
+	one := big.NewInt(1)
+	two := big.NewInt(2)
+	three := big.NewInt(3)
+
+	term := new(big.Int).Set(start)
+	for {
+		if !yield(term) {
+			return
+		}
+		if term.Cmp(one) == 0 {
+			return
+		}
+		next := new(big.Int)
+		if term.Bit(0) == 0 {
+			next.Div(term, two)
+		} else {
+			next.Mul(term, three)
+			next.Add(next, one)
+		}
+		term = next
+	}
+}
@@ -0,0 +1,63 @@
+package collatz
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCollatzSequenceKnownTrajectory(t *testing.T) {
+	seq := CollatzSequence(big.NewInt(6))
+	want := []int64{6, 3, 10, 5, 16, 8, 4, 2, 1}
+
+	if len(seq.Terms) != len(want) {
+		t.Fatalf("len(Terms) = %d, want %d", len(seq.Terms), len(want))
+	}
+	for i, term := range seq.Terms {
+		if term.Int64() != want[i] {
+			t.Errorf("Terms[%d] = %d, want %d", i, term.Int64(), want[i])
+		}
+	}
+	if seq.Iterations != len(want)-1 {
+		t.Errorf("Iterations = %d, want %d", seq.Iterations, len(want)-1)
+	}
+}
+
+func TestIterateCanStopEarly(t *testing.T) {
+	var seen []int64
+	Iterate(big.NewInt(27), func(term *big.Int) bool {
+		seen = append(seen, term.Int64())
+		return len(seen) < 3
+	})
+
+	want := []int64{27, 82, 41}
+	if len(seen) != len(want) {
+		t.Fatalf("len(seen) = %d, want %d", len(seen), len(want))
+	}
+	for i, v := range seen {
+		if v != want[i] {
+			t.Errorf("seen[%d] = %d, want %d", i, v, want[i])
+		}
+	}
+}
+
+func TestIterateRejectsNonPositiveStart(t *testing.T) {
+	for _, start := range []int64{0, -5} {
+		var seen []int64
+		Iterate(big.NewInt(start), func(term *big.Int) bool {
+			seen = append(seen, term.Int64())
+			return true
+		})
+		if len(seen) != 0 {
+			t.Errorf("Iterate(%d) yielded %v, want no terms", start, seen)
+		}
+	}
+}
+
+func TestCollatzSequenceRejectsNonPositiveStart(t *testing.T) {
+	for _, start := range []int64{0, -5} {
+		seq := CollatzSequence(big.NewInt(start))
+		if len(seq.Terms) != 0 || seq.Iterations != 0 {
+			t.Errorf("CollatzSequence(%d) = %+v, want empty", start, seq)
+		}
+	}
+}